@@ -0,0 +1,29 @@
+package manifest
+
+// CloudProvider is the `cloud_provider` section of a deployment manifest. It
+// describes which release job(s) to install for the CPI: jobs may be given
+// via the singular `template:` key, the `templates:` list, or both. Exactly
+// one of them must provide bin/cpi; the others are installed alongside it
+// as sidecar jobs (e.g. credential daemons, network plugins).
+type CloudProvider struct {
+	Template  ReleaseJobRef
+	Templates []ReleaseJobRef
+	MBus      string
+	Registry  Registry
+}
+
+// ReleaseJobRef identifies a release job by name and the release that
+// provides it.
+type ReleaseJobRef struct {
+	Name    string
+	Release string
+}
+
+// Registry holds the connection details the CPI job needs to reach the
+// bootstrap registry started during `create-env`.
+type Registry struct {
+	Username string
+	Password string
+	Host     string
+	Port     int
+}
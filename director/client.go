@@ -0,0 +1,29 @@
+package director
+
+import (
+	"fmt"
+
+	bosherr "github.com/cloudfoundry/bosh-utils/errors"
+)
+
+type Client struct {
+	clientRequest     clientRequest
+	taskClientRequest taskClientRequest
+}
+
+type TaskResp struct {
+	ID     int    `json:"id"`
+	State  string `json:"state"`
+	Result string `json:"result"`
+}
+
+func (c Client) Task(id int) (TaskResp, error) {
+	var resp TaskResp
+
+	err := c.clientRequest.Get(fmt.Sprintf("/tasks/%d", id), &resp)
+	if err != nil {
+		return resp, bosherr.WrapErrorf(err, "Fetching task '%d'", id)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,41 @@
+package director_test
+
+import (
+	"net/http"
+	"testing"
+
+	. "github.com/cloudfoundry/bosh-init/director"
+)
+
+func TestCreateClientInsecureSkipVerify(t *testing.T) {
+	client, err := CreateClient(ClientConfig{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+
+	if client == nil {
+		t.Fatal("expected a non-nil *http.Client")
+	}
+}
+
+func TestCreateClientInvalidCACert(t *testing.T) {
+	_, err := CreateClient(ClientConfig{CACert: "not-a-pem-cert"})
+	if err == nil {
+		t.Fatal("expected an error for an invalid CA certificate")
+	}
+}
+
+func TestCreateClientDoesNotFollowRedirects(t *testing.T) {
+	client, err := CreateClient(ClientConfig{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+
+	if client.CheckRedirect == nil {
+		t.Fatal("expected CheckRedirect to be set so task Location headers aren't followed")
+	}
+
+	if err := client.CheckRedirect(nil, nil); err != http.ErrUseLastResponse {
+		t.Fatalf("expected CheckRedirect to return http.ErrUseLastResponse, got: %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+package director
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	bosherr "github.com/cloudfoundry/bosh-utils/errors"
+)
+
+type clientRequest struct {
+	endpoint   string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+func newClientRequest(endpoint, username, password string, httpClient *http.Client) clientRequest {
+	return clientRequest{
+		endpoint:   endpoint,
+		username:   username,
+		password:   password,
+		httpClient: httpClient,
+	}
+}
+
+func (r clientRequest) Get(path string, response interface{}) error {
+	body, _, err := r.RawGet(path, nil)
+	if err != nil {
+		return err
+	}
+
+	defer body.Close()
+
+	return json.NewDecoder(body).Decode(response)
+}
+
+func (r clientRequest) RawGet(path string, setHeaders func(*http.Request)) (io.ReadCloser, *http.Response, error) {
+	return r.do(http.MethodGet, path, nil, setHeaders)
+}
+
+func (r clientRequest) RawPut(path string, body []byte, setHeaders func(*http.Request)) ([]byte, *http.Response, error) {
+	return r.doBytes(http.MethodPut, path, body, setHeaders)
+}
+
+func (r clientRequest) RawPost(path string, body []byte, setHeaders func(*http.Request)) ([]byte, *http.Response, error) {
+	return r.doBytes(http.MethodPost, path, body, setHeaders)
+}
+
+func (r clientRequest) RawDelete(path string) ([]byte, *http.Response, error) {
+	return r.doBytes(http.MethodDelete, path, nil, nil)
+}
+
+func (r clientRequest) doBytes(method, path string, body []byte, setHeaders func(*http.Request)) ([]byte, *http.Response, error) {
+	reader, resp, err := r.do(method, path, body, setHeaders)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	defer reader.Close()
+
+	respBody, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, resp, bosherr.WrapErrorf(err, "Reading response body")
+	}
+
+	return respBody, resp, nil
+}
+
+func (r clientRequest) do(method, path string, body []byte, setHeaders func(*http.Request)) (io.ReadCloser, *http.Response, error) {
+	var bodyReader io.Reader
+
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequest(method, r.endpoint+path, bodyReader)
+	if err != nil {
+		return nil, nil, bosherr.WrapErrorf(err, "Building request")
+	}
+
+	req.SetBasicAuth(r.username, r.password)
+
+	if setHeaders != nil {
+		setHeaders(req)
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, bosherr.WrapErrorf(err, "Performing request")
+	}
+
+	// Task-creating endpoints respond 302 with a Location pointing at the
+	// submitted task; the *http.Client returned by CreateClient doesn't
+	// follow it (see the CheckRedirect set in CreateClient), so taskIDFromLocation
+	// can read it off this response. Treat that case as success rather
+	// than an error.
+	if isTaskRedirect(resp) {
+		return resp.Body, resp, nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+
+		respBody, _ := ioutil.ReadAll(resp.Body)
+
+		return nil, resp, bosherr.Errorf("Director responded with status code '%d': '%s'", resp.StatusCode, string(respBody))
+	}
+
+	return resp.Body, resp, nil
+}
+
+func isTaskRedirect(resp *http.Response) bool {
+	return resp.StatusCode >= 300 && resp.StatusCode < 400 && resp.Header.Get("Location") != ""
+}
@@ -1,12 +1,16 @@
 package director
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	gourl "net/url"
+	"os"
 	"strings"
 
+	boshcrypto "github.com/cloudfoundry/bosh-utils/crypto"
 	bosherr "github.com/cloudfoundry/bosh-utils/errors"
 )
 
@@ -40,6 +44,11 @@ type LogsResult struct {
 	SHA1        string
 }
 
+type LogsResp struct {
+	BlobstoreID string `json:"blobstore_id"`
+	SHA1        string `json:"sha1"`
+}
+
 func (d DeploymentImpl) Name() string { return d.name }
 
 func (d *DeploymentImpl) CloudConfig() (string, error) {
@@ -75,7 +84,7 @@ func (d *DeploymentImpl) fetch() {
 		}
 	}
 
-	d.fetchErr = bosherr.Errorf("Expected to find deployment '%s'", d.name)
+	d.fetchErr = DeploymentNotFoundError{Name: d.name}
 }
 
 func (d *DeploymentImpl) fill(resp DeploymentResp) {
@@ -108,12 +117,81 @@ func (d DeploymentImpl) Manifest() (string, error) {
 }
 
 func (d DeploymentImpl) FetchLogs(slug InstanceSlug, filters []string, agent bool) (LogsResult, error) {
-	blobID, sha1, err := d.client.FetchLogs(d.name, slug.Name(), slug.IndexOrID(), filters, agent)
+	task, err := d.FetchLogsAsync(slug, filters, agent)
 	if err != nil {
 		return LogsResult{}, err
 	}
 
-	return LogsResult{BlobstoreID: blobID, SHA1: sha1}, nil
+	result, err := d.waitForResult(task)
+	if err != nil {
+		return LogsResult{}, err
+	}
+
+	var resp LogsResp
+
+	err = json.Unmarshal(result, &resp)
+	if err != nil {
+		return LogsResult{}, bosherr.WrapErrorf(err, "Unmarshaling logs result")
+	}
+
+	return LogsResult{BlobstoreID: resp.BlobstoreID, SHA1: resp.SHA1}, nil
+}
+
+// FetchLogsToFile triggers a logs task same as FetchLogs, then downloads
+// the resulting blob from the director's blobstore straight into dest,
+// verifying its SHA1 as it streams rather than requiring a separate pass
+// over the downloaded file.
+func (d DeploymentImpl) FetchLogsToFile(slug InstanceSlug, filters []string, agent bool, dest string) (LogsResult, error) {
+	result, err := d.FetchLogs(slug, filters, agent)
+	if err != nil {
+		return LogsResult{}, err
+	}
+
+	reader, _, err := d.client.DownloadResourceUnchecked(result.BlobstoreID)
+	if err != nil {
+		return LogsResult{}, err
+	}
+
+	defer reader.Close()
+
+	file, err := os.Create(dest)
+	if err != nil {
+		return LogsResult{}, bosherr.WrapErrorf(err, "Creating destination file '%s'", dest)
+	}
+
+	defer file.Close()
+
+	verifiableReader := boshcrypto.NewVerifiableReader(reader, boshcrypto.NewDigest(boshcrypto.DigestAlgorithmSHA1, result.SHA1))
+
+	_, err = io.Copy(file, verifiableReader)
+	if err != nil {
+		return LogsResult{}, bosherr.WrapErrorf(err, "Downloading logs to '%s'", dest)
+	}
+
+	err = verifiableReader.Verify()
+	if err != nil {
+		return LogsResult{}, bosherr.WrapErrorf(err, "Verifying logs downloaded to '%s'", dest)
+	}
+
+	return result, nil
+}
+
+// FetchLogsStream is like FetchLogsToFile but hands back an open reader
+// instead of writing to a path, for callers that want to pipe the logs
+// blob elsewhere (e.g. directly into a CLI's stdout). The reader is not
+// verified; the returned string is the blob's expected SHA1.
+func (d DeploymentImpl) FetchLogsStream(slug InstanceSlug, filters []string, agent bool) (io.ReadCloser, string, error) {
+	result, err := d.FetchLogs(slug, filters, agent)
+	if err != nil {
+		return nil, "", err
+	}
+
+	reader, _, err := d.client.DownloadResourceUnchecked(result.BlobstoreID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return reader, result.SHA1, nil
 }
 
 func (d DeploymentImpl) EnableResurrection(slug InstanceSlug, enabled bool) error {
@@ -121,53 +199,127 @@ func (d DeploymentImpl) EnableResurrection(slug InstanceSlug, enabled bool) erro
 }
 
 func (d DeploymentImpl) Start(slug AllOrPoolOrInstanceSlug) error {
-	return d.changeJobState("started", slug, SkipDrain{}, false)
+	task, err := d.StartAsync(slug)
+	if err != nil {
+		return err
+	}
+
+	return d.waitLockAware(task)
 }
 
 func (d DeploymentImpl) Stop(slug AllOrPoolOrInstanceSlug, hard bool, sd SkipDrain, force bool) error {
-	if hard {
-		return d.changeJobState("detached", slug, sd, force)
+	task, err := d.StopAsync(slug, hard, sd, force)
+	if err != nil {
+		return err
 	}
-	return d.changeJobState("stopped", slug, sd, force)
+
+	return d.waitLockAware(task)
 }
 
 func (d DeploymentImpl) Restart(slug AllOrPoolOrInstanceSlug, sd SkipDrain, force bool) error {
-	return d.changeJobState("restart", slug, sd, force)
+	task, err := d.RestartAsync(slug, sd, force)
+	if err != nil {
+		return err
+	}
+
+	return d.waitLockAware(task)
 }
 
 func (d DeploymentImpl) Recreate(slug AllOrPoolOrInstanceSlug, sd SkipDrain, force bool) error {
-	return d.changeJobState("recreate", slug, sd, force)
+	task, err := d.RecreateAsync(slug, sd, force)
+	if err != nil {
+		return err
+	}
+
+	return d.waitLockAware(task)
+}
+
+// waitLockAware waits for task, and if it fails re-checks the
+// deployment's lock state so callers can distinguish "someone else is
+// deploying" from other kinds of failures.
+func (d DeploymentImpl) waitLockAware(task Task) error {
+	err := task.Wait(context.Background())
+	if err != nil {
+		if lockErr := d.checkLocked(); lockErr != nil {
+			return bosherr.NewMultiError(err, lockErr)
+		}
+		return err
+	}
+
+	return nil
 }
 
-func (d DeploymentImpl) changeJobState(state string, slug AllOrPoolOrInstanceSlug, sd SkipDrain, force bool) error {
-	manifest, err := d.Manifest()
+func (d DeploymentImpl) checkLocked() error {
+	inProgress, err := d.IsInProgress()
 	if err != nil {
 		return err
 	}
 
-	return d.client.ChangeJobState(
-		state, d.name, slug.Name(), slug.IndexOrID(), sd, force, []byte(manifest))
+	if inProgress {
+		return DeploymentLockedError{Name: d.name}
+	}
+
+	return nil
 }
 
 func (d DeploymentImpl) ExportRelease(release ReleaseSlug, os OSVersionSlug) (ExportReleaseResult, error) {
-	resp, err := d.client.ExportRelease(d.name, release, os)
+	task, err := d.ExportReleaseAsync(release, os)
 	if err != nil {
 		return ExportReleaseResult{}, err
 	}
 
+	result, err := d.waitForResult(task)
+	if err != nil {
+		return ExportReleaseResult{}, err
+	}
+
+	var resp ExportReleaseResp
+
+	err = json.Unmarshal(result, &resp)
+	if err != nil {
+		return ExportReleaseResult{}, bosherr.WrapErrorf(err, "Unmarshaling export release result")
+	}
+
 	return ExportReleaseResult{BlobstoreID: resp.BlobstoreID, SHA1: resp.SHA1}, nil
 }
 
+// waitForResult waits for a task submitted by one of the *Async methods
+// and returns its raw result payload, for the operations (unlike Start/
+// Stop/Update) that hand callers back a value alongside success.
+func (d DeploymentImpl) waitForResult(task Task) ([]byte, error) {
+	err := task.Wait(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := d.client.Task(task.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(resp.Result), nil
+}
+
 func (d DeploymentImpl) Update(manifest []byte, recreate bool, sd SkipDrain) error {
-	return d.client.UpdateDeployment(manifest, recreate, sd)
+	task, err := d.UpdateAsync(manifest, recreate, sd)
+	if err != nil {
+		return err
+	}
+
+	return d.waitLockAware(task)
 }
 
 func (d DeploymentImpl) Delete(force bool) error {
-	err := d.client.DeleteDeployment(d.name, force)
+	task, err := d.DeleteAsync(force)
+	if err != nil {
+		return err
+	}
+
+	err = task.Wait(context.Background())
 	if err != nil {
 		resps, listErr := d.client.Deployments()
 		if listErr != nil {
-			return err
+			return bosherr.NewMultiError(err, listErr)
 		}
 
 		for _, resp := range resps {
@@ -197,15 +349,15 @@ func (d DeploymentImpl) IsInProgress() (bool, error) {
 
 func (c Client) FetchLogs(deploymentName, job, indexOrID string, filters []string, agent bool) (string, string, error) {
 	if len(deploymentName) == 0 {
-		return "", "", bosherr.Error("Expected non-empty deployment name")
+		return "", "", ValidationError{Field: "deployment name"}
 	}
 
 	if len(job) == 0 {
-		return "", "", bosherr.Error("Expected non-empty job name")
+		return "", "", ValidationError{Field: "job name"}
 	}
 
 	if len(indexOrID) == 0 {
-		return "", "", bosherr.Error("Expected non-empty index or ID")
+		return "", "", ValidationError{Field: "index or ID"}
 	}
 
 	query := gourl.Values{}
@@ -223,30 +375,51 @@ func (c Client) FetchLogs(deploymentName, job, indexOrID string, filters []strin
 	path := fmt.Sprintf("/deployments/%s/jobs/%s/%s/logs?%s",
 		deploymentName, job, indexOrID, query.Encode())
 
-	taskID, _, err := c.taskClientRequest.GetResult(path)
+	_, result, err := c.taskClientRequest.GetResult(path)
 	if err != nil {
 		return "", "", bosherr.WrapErrorf(err, "Fetching logs")
 	}
 
-	taskResp, err := c.Task(taskID)
+	var resp LogsResp
+
+	err = json.Unmarshal(result, &resp)
+	if err != nil {
+		return "", "", bosherr.WrapErrorf(err, "Unmarshaling logs result")
+	}
+
+	return resp.BlobstoreID, resp.SHA1, nil
+}
+
+// DownloadResourceUnchecked opens a stream to the director's blobstore
+// resource endpoint for the given blobstore ID. Callers are responsible
+// for closing the returned reader and for verifying its contents; use
+// DeploymentImpl.FetchLogsToFile for a verified download.
+func (c Client) DownloadResourceUnchecked(blobstoreID string) (io.ReadCloser, *http.Response, error) {
+	if len(blobstoreID) == 0 {
+		return nil, nil, ValidationError{Field: "blobstore ID"}
+	}
+
+	path := fmt.Sprintf("/resources/%s", blobstoreID)
+
+	body, resp, err := c.clientRequest.RawGet(path, nil)
 	if err != nil {
-		return "", "", err
+		return nil, resp, bosherr.WrapErrorf(err, "Downloading resource '%s'", blobstoreID)
 	}
 
-	return taskResp.Result, "", nil
+	return body, resp, nil
 }
 
 func (c Client) EnableResurrection(deploymentName, job, indexOrID string, enabled bool) error {
 	if len(deploymentName) == 0 {
-		return bosherr.Error("Expected non-empty deployment name")
+		return ValidationError{Field: "deployment name"}
 	}
 
 	if len(job) == 0 {
-		return bosherr.Error("Expected non-empty job name")
+		return ValidationError{Field: "job name"}
 	}
 
 	if len(indexOrID) == 0 {
-		return bosherr.Error("Expected non-empty index or ID")
+		return ValidationError{Field: "index or ID"}
 	}
 
 	path := fmt.Sprintf("/deployments/%s/jobs/%s/%s/resurrection",
@@ -274,11 +447,11 @@ func (c Client) EnableResurrection(deploymentName, job, indexOrID string, enable
 
 func (c Client) ChangeJobState(state, deploymentName, job, indexOrID string, sd SkipDrain, force bool, manifest []byte) error {
 	if len(state) == 0 {
-		return bosherr.Error("Expected non-empty job state")
+		return ValidationError{Field: "job state"}
 	}
 
 	if len(deploymentName) == 0 {
-		return bosherr.Error("Expected non-empty deployment name")
+		return ValidationError{Field: "deployment name"}
 	}
 
 	// allows to have empty job and indexOrID
@@ -325,23 +498,23 @@ func (c Client) ExportRelease(deploymentName string, release ReleaseSlug, os OSV
 	var resp ExportReleaseResp
 
 	if len(deploymentName) == 0 {
-		return resp, bosherr.Error("Expected non-empty deployment name")
+		return resp, ValidationError{Field: "deployment name"}
 	}
 
 	if len(release.Name()) == 0 {
-		return resp, bosherr.Error("Expected non-empty release name")
+		return resp, ValidationError{Field: "release name"}
 	}
 
 	if len(release.Version()) == 0 {
-		return resp, bosherr.Error("Expected non-empty release version")
+		return resp, ValidationError{Field: "release version"}
 	}
 
 	if len(os.OS()) == 0 {
-		return resp, bosherr.Error("Expected non-empty OS name")
+		return resp, ValidationError{Field: "OS name"}
 	}
 
 	if len(os.Version()) == 0 {
-		return resp, bosherr.Error("Expected non-empty OS version")
+		return resp, ValidationError{Field: "OS version"}
 	}
 
 	path := "/releases/export"
@@ -403,7 +576,7 @@ func (c Client) UpdateDeployment(manifest []byte, recreate bool, sd SkipDrain) e
 
 func (c Client) DeleteDeployment(deploymentName string, force bool) error {
 	if len(deploymentName) == 0 {
-		return bosherr.Error("Expected non-empty deployment name")
+		return ValidationError{Field: "deployment name"}
 	}
 
 	query := gourl.Values{}
@@ -432,7 +605,7 @@ type VMResp struct {
 
 func (c Client) DeploymentVMs(deploymentName string) ([]VMResp, error) {
 	if len(deploymentName) == 0 {
-		return nil, bosherr.Error("Expected non-empty deployment name")
+		return nil, ValidationError{Field: "deployment name"}
 	}
 
 	var vms []VMResp
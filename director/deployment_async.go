@@ -0,0 +1,265 @@
+package director
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	gourl "net/url"
+	"strings"
+
+	bosherr "github.com/cloudfoundry/bosh-utils/errors"
+)
+
+// StartAsync, StopAsync, RestartAsync, RecreateAsync, UpdateAsync,
+// DeleteAsync, ExportReleaseAsync, and FetchLogsAsync submit their
+// director task and return immediately with a Task handle, instead of
+// blocking until the task finishes. The synchronous Start/Stop/Restart/
+// Recreate/Update/Delete/ExportRelease/FetchLogs methods are implemented
+// in terms of these by calling Wait(context.Background()).
+
+func (d DeploymentImpl) StartAsync(slug AllOrPoolOrInstanceSlug) (Task, error) {
+	return d.changeJobStateAsync("started", slug, SkipDrain{}, false)
+}
+
+func (d DeploymentImpl) StopAsync(slug AllOrPoolOrInstanceSlug, hard bool, sd SkipDrain, force bool) (Task, error) {
+	if hard {
+		return d.changeJobStateAsync("detached", slug, sd, force)
+	}
+	return d.changeJobStateAsync("stopped", slug, sd, force)
+}
+
+func (d DeploymentImpl) RestartAsync(slug AllOrPoolOrInstanceSlug, sd SkipDrain, force bool) (Task, error) {
+	return d.changeJobStateAsync("restart", slug, sd, force)
+}
+
+func (d DeploymentImpl) RecreateAsync(slug AllOrPoolOrInstanceSlug, sd SkipDrain, force bool) (Task, error) {
+	return d.changeJobStateAsync("recreate", slug, sd, force)
+}
+
+func (d DeploymentImpl) changeJobStateAsync(state string, slug AllOrPoolOrInstanceSlug, sd SkipDrain, force bool) (Task, error) {
+	manifest, err := d.Manifest()
+	if err != nil {
+		return nil, err
+	}
+
+	taskID, err := d.client.ChangeJobStateAsync(
+		state, d.name, slug.Name(), slug.IndexOrID(), sd, force, []byte(manifest))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(d.client, taskID), nil
+}
+
+func (d DeploymentImpl) UpdateAsync(manifest []byte, recreate bool, sd SkipDrain) (Task, error) {
+	taskID, err := d.client.UpdateDeploymentAsync(manifest, recreate, sd)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(d.client, taskID), nil
+}
+
+func (d DeploymentImpl) DeleteAsync(force bool) (Task, error) {
+	taskID, err := d.client.DeleteDeploymentAsync(d.name, force)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(d.client, taskID), nil
+}
+
+func (d DeploymentImpl) ExportReleaseAsync(release ReleaseSlug, os OSVersionSlug) (Task, error) {
+	taskID, err := d.client.ExportReleaseAsync(d.name, release, os)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(d.client, taskID), nil
+}
+
+func (d DeploymentImpl) FetchLogsAsync(slug InstanceSlug, filters []string, agent bool) (Task, error) {
+	taskID, err := d.client.FetchLogsAsync(d.name, slug.Name(), slug.IndexOrID(), filters, agent)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(d.client, taskID), nil
+}
+
+func (c Client) ChangeJobStateAsync(state, deploymentName, job, indexOrID string, sd SkipDrain, force bool, manifest []byte) (int, error) {
+	if len(state) == 0 {
+		return 0, ValidationError{Field: "job state"}
+	}
+
+	if len(deploymentName) == 0 {
+		return 0, ValidationError{Field: "deployment name"}
+	}
+
+	query := gourl.Values{}
+
+	query.Add("state", state)
+
+	if len(sd.AsQueryValue()) > 0 {
+		query.Add("skip_drain", sd.AsQueryValue())
+	}
+
+	if force {
+		query.Add("force", "true")
+	}
+
+	path := fmt.Sprintf("/deployments/%s/jobs", deploymentName)
+
+	if len(job) > 0 {
+		path += "/" + job
+
+		if len(indexOrID) > 0 {
+			path += "/" + indexOrID
+		}
+	} else {
+		path += "/*"
+	}
+
+	path += "?" + query.Encode()
+
+	setHeaders := func(req *http.Request) {
+		req.Header.Add("Content-Type", "text/yaml")
+	}
+
+	taskID, err := c.taskClientRequest.Put(path, manifest, setHeaders)
+	if err != nil {
+		return 0, bosherr.WrapErrorf(err, "Changing state")
+	}
+
+	return taskID, nil
+}
+
+func (c Client) UpdateDeploymentAsync(manifest []byte, recreate bool, sd SkipDrain) (int, error) {
+	query := gourl.Values{}
+
+	if recreate {
+		query.Add("recreate", "true")
+	}
+
+	if len(sd.AsQueryValue()) > 0 {
+		query.Add("skip_drain", sd.AsQueryValue())
+	}
+
+	path := fmt.Sprintf("/deployments?%s", query.Encode())
+
+	setHeaders := func(req *http.Request) {
+		req.Header.Add("Content-Type", "text/yaml")
+	}
+
+	taskID, err := c.taskClientRequest.Post(path, manifest, setHeaders)
+	if err != nil {
+		return 0, bosherr.WrapErrorf(err, "Updating deployment")
+	}
+
+	return taskID, nil
+}
+
+func (c Client) DeleteDeploymentAsync(deploymentName string, force bool) (int, error) {
+	if len(deploymentName) == 0 {
+		return 0, ValidationError{Field: "deployment name"}
+	}
+
+	query := gourl.Values{}
+
+	if force {
+		query.Add("force", "true")
+	}
+
+	path := fmt.Sprintf("/deployments/%s?%s", deploymentName, query.Encode())
+
+	taskID, err := c.taskClientRequest.Delete(path)
+	if err != nil {
+		return 0, bosherr.WrapErrorf(err, "Deleting deployment '%s'", deploymentName)
+	}
+
+	return taskID, nil
+}
+
+func (c Client) ExportReleaseAsync(deploymentName string, release ReleaseSlug, os OSVersionSlug) (int, error) {
+	if len(deploymentName) == 0 {
+		return 0, ValidationError{Field: "deployment name"}
+	}
+
+	if len(release.Name()) == 0 {
+		return 0, ValidationError{Field: "release name"}
+	}
+
+	if len(release.Version()) == 0 {
+		return 0, ValidationError{Field: "release version"}
+	}
+
+	if len(os.OS()) == 0 {
+		return 0, ValidationError{Field: "OS name"}
+	}
+
+	if len(os.Version()) == 0 {
+		return 0, ValidationError{Field: "OS version"}
+	}
+
+	path := "/releases/export"
+
+	body := map[string]string{
+		"deployment_name":  deploymentName,
+		"release_name":     release.Name(),
+		"release_version":  release.Version(),
+		"stemcell_os":      os.OS(),
+		"stemcell_version": os.Version(),
+	}
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return 0, bosherr.WrapErrorf(err, "Marshaling request body")
+	}
+
+	setHeaders := func(req *http.Request) {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	taskID, err := c.taskClientRequest.Post(path, reqBody, setHeaders)
+	if err != nil {
+		return 0, bosherr.WrapErrorf(err, "Exporting release")
+	}
+
+	return taskID, nil
+}
+
+func (c Client) FetchLogsAsync(deploymentName, job, indexOrID string, filters []string, agent bool) (int, error) {
+	if len(deploymentName) == 0 {
+		return 0, ValidationError{Field: "deployment name"}
+	}
+
+	if len(job) == 0 {
+		return 0, ValidationError{Field: "job name"}
+	}
+
+	if len(indexOrID) == 0 {
+		return 0, ValidationError{Field: "index or ID"}
+	}
+
+	query := gourl.Values{}
+
+	if len(filters) > 0 {
+		query.Add("filters", strings.Join(filters, ","))
+	}
+
+	if agent {
+		query.Add("type", "agent")
+	} else {
+		query.Add("type", "job")
+	}
+
+	path := fmt.Sprintf("/deployments/%s/jobs/%s/%s/logs?%s",
+		deploymentName, job, indexOrID, query.Encode())
+
+	taskID, err := c.taskClientRequest.Get(path)
+	if err != nil {
+		return 0, bosherr.WrapErrorf(err, "Fetching logs")
+	}
+
+	return taskID, nil
+}
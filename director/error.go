@@ -0,0 +1,103 @@
+package director
+
+import (
+	"fmt"
+
+	bosherr "github.com/cloudfoundry/bosh-utils/errors"
+)
+
+// DeploymentNotFoundError is returned when the director has no deployment
+// matching the requested name.
+type DeploymentNotFoundError struct {
+	Name string
+}
+
+func (e DeploymentNotFoundError) Error() string {
+	return fmt.Sprintf("Expected to find deployment '%s'", e.Name)
+}
+
+// DeploymentLockedError is returned when an operation cannot proceed
+// because another operation currently holds a lock on the deployment.
+type DeploymentLockedError struct {
+	Name string
+}
+
+func (e DeploymentLockedError) Error() string {
+	return fmt.Sprintf("Deployment '%s' is locked by another operation", e.Name)
+}
+
+// TaskFailedError is returned when waiting on a director task (from a
+// synchronous operation or a Task handle's Wait) and the task finishes in
+// a non-'done' state.
+type TaskFailedError struct {
+	TaskID int
+	State  string
+	Result string
+}
+
+func (e TaskFailedError) Error() string {
+	return fmt.Sprintf("Task '%d' finished in state '%s': %s", e.TaskID, e.State, e.Result)
+}
+
+// ValidationError is returned when a caller-supplied argument fails
+// client-side validation before any request is made.
+type ValidationError struct {
+	Field string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("Expected non-empty %s", e.Field)
+}
+
+// IsNotFound returns true if err (or one of the errors it wraps via
+// bosherr.MultiError or bosherr.WrapError/WrapErrorf) is a
+// DeploymentNotFoundError.
+func IsNotFound(err error) bool {
+	return matchesErrType(err, func(e error) bool {
+		_, ok := e.(DeploymentNotFoundError)
+		return ok
+	})
+}
+
+// IsLocked returns true if err (or one of the errors it wraps via
+// bosherr.MultiError or bosherr.WrapError/WrapErrorf) is a
+// DeploymentLockedError.
+func IsLocked(err error) bool {
+	return matchesErrType(err, func(e error) bool {
+		_, ok := e.(DeploymentLockedError)
+		return ok
+	})
+}
+
+// IsTaskFailed returns true if err (or one of the errors it wraps via
+// bosherr.MultiError or bosherr.WrapError/WrapErrorf) is a TaskFailedError.
+func IsTaskFailed(err error) bool {
+	return matchesErrType(err, func(e error) bool {
+		_, ok := e.(TaskFailedError)
+		return ok
+	})
+}
+
+func matchesErrType(err error, match func(error) bool) bool {
+	if err == nil {
+		return false
+	}
+
+	if match(err) {
+		return true
+	}
+
+	if multiErr, ok := err.(bosherr.MultiError); ok {
+		for _, err := range multiErr.Errors {
+			if matchesErrType(err, match) {
+				return true
+			}
+		}
+	}
+
+	if complexErr, ok := err.(bosherr.ComplexError); ok {
+		return matchesErrType(complexErr.Cause, match)
+	}
+
+	return false
+}
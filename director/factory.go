@@ -0,0 +1,35 @@
+package director
+
+import (
+	"fmt"
+
+	bosherr "github.com/cloudfoundry/bosh-utils/errors"
+)
+
+type FactoryConfig struct {
+	Host string
+	Port int
+
+	Username string
+	Password string
+
+	Client ClientConfig
+}
+
+func (c FactoryConfig) endpoint() string {
+	return fmt.Sprintf("https://%s:%d", c.Host, c.Port)
+}
+
+func NewDirector(config FactoryConfig) (Client, error) {
+	httpClient, err := CreateClient(config.Client)
+	if err != nil {
+		return Client{}, bosherr.WrapErrorf(err, "Creating HTTP client")
+	}
+
+	cr := newClientRequest(config.endpoint(), config.Username, config.Password, httpClient)
+
+	return Client{
+		clientRequest:     cr,
+		taskClientRequest: newTaskClientRequest(cr),
+	}, nil
+}
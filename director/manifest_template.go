@@ -0,0 +1,74 @@
+package director
+
+import (
+	boshtpl "github.com/cloudfoundry/bosh-init/director/template"
+	"github.com/cloudfoundry/go-patch/patch"
+	bosherr "github.com/cloudfoundry/bosh-utils/errors"
+)
+
+// UpdateWithTemplate interpolates tpl by applying ops and substituting
+// ((var)) placeholders from vars before posting the result, so an
+// operator's source-controlled manifest template never needs to carry
+// secrets itself. vars is commonly a boshtpl.MultiVars over env/file/
+// literal-map sources, or a DirectorVariables backed by the director's
+// own Config Server.
+func (d DeploymentImpl) UpdateWithTemplate(tpl boshtpl.Template, vars boshtpl.Variables, ops patch.Ops, recreate bool, sd SkipDrain) error {
+	manifest, err := interpolateManifest(tpl, vars, ops)
+	if err != nil {
+		return err
+	}
+
+	return d.Update(manifest, recreate, sd)
+}
+
+// ManifestWithTemplate runs the same ops-and-variables pipeline as
+// UpdateWithTemplate without submitting anything, letting callers
+// retrieve the fully interpolated manifest the director would receive
+// (or would have stored) for e.g. diffing against Manifest().
+func (d DeploymentImpl) ManifestWithTemplate(tpl boshtpl.Template, vars boshtpl.Variables, ops patch.Ops) (string, error) {
+	manifest, err := interpolateManifest(tpl, vars, ops)
+	if err != nil {
+		return "", err
+	}
+
+	return string(manifest), nil
+}
+
+func interpolateManifest(tpl boshtpl.Template, vars boshtpl.Variables, ops patch.Ops) ([]byte, error) {
+	bytes, err := tpl.Evaluate(vars, ops, boshtpl.EvaluateOpts{ExpectAllKeys: true})
+	if err != nil {
+		return nil, bosherr.WrapErrorf(err, "Interpolating manifest template")
+	}
+
+	return bytes, nil
+}
+
+// DirectorVariables resolves ((var)) placeholders against the director's
+// Config Server integration (/variables), generating defaults for
+// well-known types (password, certificate, ssh, rsa) the first time a
+// variable is requested, so manifests never need those values supplied
+// locally at all.
+type DirectorVariables struct {
+	client Client
+}
+
+func NewDirectorVariables(client Client) DirectorVariables {
+	return DirectorVariables{client: client}
+}
+
+func (v DirectorVariables) Get(varDef boshtpl.VariableDefinition) (interface{}, bool, error) {
+	resp, err := v.client.Variable(varDef.Name, varDef.Type)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resp.Value, true, nil
+}
+
+func (v DirectorVariables) List() ([]boshtpl.VariableDefinition, error) {
+	return nil, bosherr.Error("Listing variables is not supported against a director's Config Server")
+}
+
+type VariableResp struct {
+	Value interface{} `json:"value"`
+}
@@ -0,0 +1,135 @@
+package director
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	bosherr "github.com/cloudfoundry/bosh-utils/errors"
+)
+
+// taskClientRequest submits a request that the director runs as a task.
+// The Get/Put/Post/Delete methods return as soon as the task is
+// submitted (for DeploymentImpl's *Async methods); the *Result variants
+// additionally poll the task to completion and return its result.
+type taskClientRequest struct {
+	clientRequest clientRequest
+}
+
+func newTaskClientRequest(clientRequest clientRequest) taskClientRequest {
+	return taskClientRequest{clientRequest: clientRequest}
+}
+
+func (r taskClientRequest) Get(path string) (int, error) {
+	return r.submit(http.MethodGet, path, nil, nil)
+}
+
+func (r taskClientRequest) Put(path string, body []byte, setHeaders func(*http.Request)) (int, error) {
+	return r.submit(http.MethodPut, path, body, setHeaders)
+}
+
+func (r taskClientRequest) Post(path string, body []byte, setHeaders func(*http.Request)) (int, error) {
+	return r.submit(http.MethodPost, path, body, setHeaders)
+}
+
+func (r taskClientRequest) Delete(path string) (int, error) {
+	return r.submit(http.MethodDelete, path, nil, nil)
+}
+
+func (r taskClientRequest) GetResult(path string) (int, []byte, error) {
+	return r.submitAndWait(http.MethodGet, path, nil, nil)
+}
+
+func (r taskClientRequest) PutResult(path string, body []byte, setHeaders func(*http.Request)) ([]byte, error) {
+	_, result, err := r.submitAndWait(http.MethodPut, path, body, setHeaders)
+	return result, err
+}
+
+func (r taskClientRequest) PostResult(path string, body []byte, setHeaders func(*http.Request)) ([]byte, error) {
+	_, result, err := r.submitAndWait(http.MethodPost, path, body, setHeaders)
+	return result, err
+}
+
+func (r taskClientRequest) DeleteResult(path string) ([]byte, error) {
+	_, result, err := r.submitAndWait(http.MethodDelete, path, nil, nil)
+	return result, err
+}
+
+func (r taskClientRequest) submit(method, path string, body []byte, setHeaders func(*http.Request)) (int, error) {
+	var resp *http.Response
+	var err error
+
+	switch method {
+	case http.MethodGet:
+		var respBody io.ReadCloser
+		respBody, resp, err = r.clientRequest.RawGet(path, setHeaders)
+		if respBody != nil {
+			respBody.Close()
+		}
+	case http.MethodPut:
+		_, resp, err = r.clientRequest.RawPut(path, body, setHeaders)
+	case http.MethodPost:
+		_, resp, err = r.clientRequest.RawPost(path, body, setHeaders)
+	case http.MethodDelete:
+		_, resp, err = r.clientRequest.RawDelete(path)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return taskIDFromLocation(resp)
+}
+
+func (r taskClientRequest) submitAndWait(method, path string, body []byte, setHeaders func(*http.Request)) (int, []byte, error) {
+	taskID, err := r.submit(method, path, body, setHeaders)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	taskResp, err := r.waitForTask(taskID)
+	if err != nil {
+		return taskID, nil, err
+	}
+
+	return taskID, []byte(taskResp.Result), nil
+}
+
+func (r taskClientRequest) waitForTask(id int) (TaskResp, error) {
+	for {
+		var resp TaskResp
+
+		err := r.clientRequest.Get(fmt.Sprintf("/tasks/%d", id), &resp)
+		if err != nil {
+			return resp, err
+		}
+
+		switch resp.State {
+		case "done":
+			return resp, nil
+		case "error", "errored", "cancelled", "timeout":
+			return resp, TaskFailedError{TaskID: id, State: resp.State, Result: resp.Result}
+		}
+
+		time.Sleep(taskPollInterval)
+	}
+}
+
+func taskIDFromLocation(resp *http.Response) (int, error) {
+	if resp == nil {
+		return 0, bosherr.Error("Expected a response with a Location header pointing at the submitted task")
+	}
+
+	location := strings.TrimRight(resp.Header.Get("Location"), "/")
+	segments := strings.Split(location, "/")
+
+	id, err := strconv.Atoi(segments[len(segments)-1])
+	if err != nil {
+		return 0, bosherr.WrapErrorf(err, "Parsing task ID from Location header '%s'", location)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,32 @@
+package director
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTaskClientRequestSubmitReadsTaskIDFrom302Location(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Location", "/tasks/42")
+		w.WriteHeader(http.StatusFound)
+	}))
+	defer server.Close()
+
+	httpClient, err := CreateClient(ClientConfig{})
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+
+	cr := newClientRequest(server.URL, "", "", httpClient)
+	tcr := newTaskClientRequest(cr)
+
+	taskID, err := tcr.submit(http.MethodGet, "/deployments/foo/jobs/bar/0/logs", nil, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+
+	if taskID != 42 {
+		t.Fatalf("expected task ID 42, got: %d", taskID)
+	}
+}
@@ -0,0 +1,163 @@
+package director
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	bosherr "github.com/cloudfoundry/bosh-utils/errors"
+)
+
+// OutputType selects which of a task's output streams to fetch from the
+// director's /tasks/:id/output endpoint.
+type OutputType string
+
+const (
+	OutputTypeEvent  OutputType = "event"
+	OutputTypeResult OutputType = "result"
+	OutputTypeCPI    OutputType = "cpi"
+	OutputTypeDebug  OutputType = "debug"
+)
+
+// TaskEvent is a single line of a task's event log, as streamed from
+// /tasks/:id/output?type=event.
+type TaskEvent struct {
+	Time  int64    `json:"time"`
+	Stage string   `json:"stage"`
+	Tags  []string `json:"tags"`
+
+	Total int    `json:"total"`
+	Task  string `json:"task"`
+	Index int    `json:"index"`
+
+	State    string `json:"state"`
+	Progress int    `json:"progress"`
+}
+
+const taskPollInterval = 1 * time.Second
+
+// Task is a handle onto a director task submitted without waiting for it
+// to finish, so long-running deployment operations can be watched,
+// time-bound, or cancelled instead of blocking a goroutine outright.
+type Task interface {
+	ID() int
+	State() (string, error)
+	Wait(ctx context.Context) error
+	Cancel() error
+	EventStream(ctx context.Context) (<-chan TaskEvent, error)
+	Output(outputType OutputType) (io.ReadCloser, error)
+}
+
+type taskImpl struct {
+	client Client
+	id     int
+}
+
+// NewTask wraps an already-submitted director task ID as a Task handle.
+func NewTask(client Client, id int) Task {
+	return &taskImpl{client: client, id: id}
+}
+
+func (t *taskImpl) ID() int { return t.id }
+
+func (t *taskImpl) State() (string, error) {
+	resp, err := t.client.Task(t.id)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.State, nil
+}
+
+// Wait polls the task until it reaches a terminal state or ctx is done,
+// whichever comes first.
+func (t *taskImpl) Wait(ctx context.Context) error {
+	for {
+		resp, err := t.client.Task(t.id)
+		if err != nil {
+			return err
+		}
+
+		switch resp.State {
+		case "done":
+			return nil
+		case "error", "errored", "cancelled", "timeout":
+			return TaskFailedError{TaskID: t.id, State: resp.State, Result: resp.Result}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(taskPollInterval):
+		}
+	}
+}
+
+// Cancel asks the director to cancel the task via DELETE /tasks/:id.
+func (t *taskImpl) Cancel() error {
+	return t.client.CancelTask(t.id)
+}
+
+// EventStream streams the task's event output, decoding one TaskEvent per
+// line until the output closes or ctx is done.
+func (t *taskImpl) EventStream(ctx context.Context) (<-chan TaskEvent, error) {
+	body, err := t.client.TaskOutput(t.id, OutputTypeEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan TaskEvent)
+
+	go func() {
+		defer close(events)
+		defer body.Close()
+
+		decoder := json.NewDecoder(body)
+
+		for {
+			var event TaskEvent
+
+			err := decoder.Decode(&event)
+			if err != nil {
+				return
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// Output returns a reader over one of the task's raw output streams.
+func (t *taskImpl) Output(outputType OutputType) (io.ReadCloser, error) {
+	return t.client.TaskOutput(t.id, outputType)
+}
+
+func (c Client) CancelTask(id int) error {
+	path := fmt.Sprintf("/tasks/%d", id)
+
+	_, _, err := c.clientRequest.RawDelete(path)
+	if err != nil {
+		return bosherr.WrapErrorf(err, "Cancelling task '%d'", id)
+	}
+
+	return nil
+}
+
+func (c Client) TaskOutput(id int, outputType OutputType) (io.ReadCloser, error) {
+	path := fmt.Sprintf("/tasks/%d/output?type=%s", id, outputType)
+
+	body, _, err := c.clientRequest.RawGet(path, nil)
+	if err != nil {
+		return nil, bosherr.WrapErrorf(err, "Fetching task '%d' output", id)
+	}
+
+	return body, nil
+}
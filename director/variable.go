@@ -0,0 +1,50 @@
+package director
+
+import (
+	"encoding/json"
+	"net/http"
+
+	bosherr "github.com/cloudfoundry/bosh-utils/errors"
+)
+
+// Variable fetches a variable from the director's Config Server
+// integration, generating a default value for well-known types
+// (password, certificate, ssh, rsa) the first time it's requested if one
+// doesn't already exist. Generation happens director-side, which is why
+// this POSTs to /variables (a GET would 404 on a variable that hasn't
+// been generated yet) rather than reading it.
+func (c Client) Variable(name, varType string) (VariableResp, error) {
+	var resp VariableResp
+
+	if len(name) == 0 {
+		return resp, ValidationError{Field: "variable name"}
+	}
+
+	reqBody, err := json.Marshal(VariableReq{Name: name, Type: varType})
+	if err != nil {
+		return resp, bosherr.WrapErrorf(err, "Marshaling variable request for '%s'", name)
+	}
+
+	setHeaders := func(req *http.Request) {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	respBody, _, err := c.clientRequest.RawPost("/variables", reqBody, setHeaders)
+	if err != nil {
+		return resp, bosherr.WrapErrorf(err, "Fetching variable '%s'", name)
+	}
+
+	err = json.Unmarshal(respBody, &resp)
+	if err != nil {
+		return resp, bosherr.WrapErrorf(err, "Unmarshaling variable '%s' response", name)
+	}
+
+	return resp, nil
+}
+
+// VariableReq is the body of a POST to /variables: the variable to fetch
+// (generating it, with a default for its type, if it doesn't yet exist).
+type VariableReq struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
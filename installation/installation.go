@@ -0,0 +1,73 @@
+package installation
+
+import (
+	"path/filepath"
+
+	bistatejob "github.com/cloudfoundry/bosh-init/state/job"
+	bosherr "github.com/cloudfoundry/bosh-utils/errors"
+	boshsys "github.com/cloudfoundry/bosh-utils/system"
+)
+
+// Installation represents the on-disk result of installing a CPI release's
+// jobs onto the local system. Exactly one of the installed jobs must
+// provide bin/cpi; any others are installed alongside it as sidecar jobs
+// (e.g. credential daemons, network plugins) that the CPI can shell out to.
+//
+// Job() is what cloud.Factory.NewCloud and its cmd/deployment_deleter.go
+// and cmd/create_env.go callers are expected to use to locate bin/cpi;
+// Jobs() exists for callers that also need to reach the sidecar jobs
+// directly (e.g. to point the CPI at a companion job's rendered config).
+type Installation interface {
+	Target() Target
+	Job() bistatejob.InstalledJob
+	Jobs() []bistatejob.InstalledJob
+}
+
+type installationImpl struct {
+	target Target
+	job    bistatejob.InstalledJob
+	jobs   []bistatejob.InstalledJob
+}
+
+// NewInstallation builds an Installation from the full set of rendered and
+// compiled jobs, picking out the single job that provides bin/cpi.
+func NewInstallation(target Target, jobs []bistatejob.InstalledJob, fs boshsys.FileSystem) (Installation, error) {
+	cpiJob, err := findCPIJob(jobs, fs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &installationImpl{
+		target: target,
+		job:    cpiJob,
+		jobs:   jobs,
+	}, nil
+}
+
+func findCPIJob(jobs []bistatejob.InstalledJob, fs boshsys.FileSystem) (bistatejob.InstalledJob, error) {
+	var cpiJob bistatejob.InstalledJob
+	found := false
+
+	for _, job := range jobs {
+		if fs.FileExists(filepath.Join(job.Path, "bin", "cpi")) {
+			if found {
+				return bistatejob.InstalledJob{}, bosherr.Errorf("Expected exactly one installed job to provide 'bin/cpi', but found multiple ('%s' and '%s')", cpiJob.Name, job.Name)
+			}
+
+			cpiJob = job
+			found = true
+		}
+	}
+
+	if !found {
+		return bistatejob.InstalledJob{}, bosherr.Error("Expected exactly one installed job to provide 'bin/cpi', but found none")
+	}
+
+	return cpiJob, nil
+}
+
+func (i *installationImpl) Target() Target { return i.target }
+
+func (i *installationImpl) Job() bistatejob.InstalledJob { return i.job }
+
+func (i *installationImpl) Jobs() []bistatejob.InstalledJob { return i.jobs }
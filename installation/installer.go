@@ -0,0 +1,104 @@
+package installation
+
+import (
+	bideplmanifest "github.com/cloudfoundry/bosh-init/deployment/manifest"
+	"github.com/cloudfoundry/bosh-init/installation/blobextract"
+	biregistry "github.com/cloudfoundry/bosh-init/registry"
+	bistatejob "github.com/cloudfoundry/bosh-init/state/job"
+	biui "github.com/cloudfoundry/bosh-init/ui"
+	bosherr "github.com/cloudfoundry/bosh-utils/errors"
+	boshlog "github.com/cloudfoundry/bosh-utils/logger"
+	boshsys "github.com/cloudfoundry/bosh-utils/system"
+)
+
+type Installer interface {
+	// Install renders, compiles, and places every job referenced by the
+	// cloud_provider section of the deployment manifest (via the singular
+	// `template:` key and the `templates:` list) under the installation
+	// target. Exactly one of those jobs must provide bin/cpi.
+	Install(cloudProvider bideplmanifest.CloudProvider, stage biui.Stage) (Installation, error)
+	Cleanup(installation Installation) error
+}
+
+type installerImpl struct {
+	target                Target
+	jobRenderer           JobRenderer
+	jobResolver           JobResolver
+	packageCompiler       PackageCompiler
+	blobExtractor         blobextract.Extractor
+	registryServerManager biregistry.ServerManager
+	fs                    boshsys.FileSystem
+	logger                boshlog.Logger
+	logTag                string
+}
+
+func NewInstaller(
+	target Target,
+	jobRenderer JobRenderer,
+	jobResolver JobResolver,
+	packageCompiler PackageCompiler,
+	blobExtractor blobextract.Extractor,
+	registryServerManager biregistry.ServerManager,
+	logger boshlog.Logger,
+	fs boshsys.FileSystem,
+) Installer {
+	return &installerImpl{
+		target:                target,
+		jobRenderer:           jobRenderer,
+		jobResolver:           jobResolver,
+		packageCompiler:       packageCompiler,
+		blobExtractor:         blobExtractor,
+		registryServerManager: registryServerManager,
+		fs:     fs,
+		logger: logger,
+		logTag: "installer",
+	}
+}
+
+func (i *installerImpl) Install(cloudProvider bideplmanifest.CloudProvider, stage biui.Stage) (Installation, error) {
+	jobRefs := cloudProviderJobRefs(cloudProvider)
+
+	installedJobs := make([]bistatejob.InstalledJob, 0, len(jobRefs))
+
+	for _, jobRef := range jobRefs {
+		releaseJob, err := i.jobResolver.Resolve(jobRef.Name, jobRef.Release)
+		if err != nil {
+			return nil, bosherr.WrapErrorf(err, "Resolving job '%s'", jobRef.Name)
+		}
+
+		err = i.packageCompiler.Compile(releaseJob, stage)
+		if err != nil {
+			return nil, bosherr.WrapErrorf(err, "Compiling job '%s' packages", jobRef.Name)
+		}
+
+		installedJob, err := i.jobRenderer.RenderAndUploadFrom(releaseJob, i.target.JobsPath(), stage)
+		if err != nil {
+			return nil, bosherr.WrapErrorf(err, "Rendering job '%s'", jobRef.Name)
+		}
+
+		installedJobs = append(installedJobs, installedJob)
+	}
+
+	return NewInstallation(i.target, installedJobs, i.fs)
+}
+
+func (i *installerImpl) Cleanup(installation Installation) error {
+	return nil
+}
+
+// cloudProviderJobRefs returns the full list of jobs to install for a
+// cloud_provider section, accepting both the legacy singular `template:`
+// key and the newer `templates:` list (which may also carry sidecar jobs
+// shipped alongside the CPI job) so existing manifests keep working
+// unmodified.
+func cloudProviderJobRefs(cloudProvider bideplmanifest.CloudProvider) []bideplmanifest.ReleaseJobRef {
+	jobRefs := make([]bideplmanifest.ReleaseJobRef, 0, len(cloudProvider.Templates)+1)
+
+	if cloudProvider.Template.Name != "" {
+		jobRefs = append(jobRefs, cloudProvider.Template)
+	}
+
+	jobRefs = append(jobRefs, cloudProvider.Templates...)
+
+	return jobRefs
+}
@@ -76,6 +76,7 @@ func (f *installerFactory) NewInstaller(target Target) Installer {
 		context.BlobExtractor(),
 		f.registryServerManager,
 		f.logger,
+		f.fs,
 	)
 }
 